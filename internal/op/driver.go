@@ -1,12 +1,14 @@
 package op
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 
 	"github.com/OpenListTeam/OpenList/v4/internal/conf"
 
 	"github.com/OpenListTeam/OpenList/v4/internal/driver"
+	"github.com/OpenListTeam/OpenList/v4/pkg/utils"
 	"github.com/pkg/errors"
 )
 
@@ -43,6 +45,104 @@ func GetDriverInfoMap() map[string]driver.Info {
 	return driverInfoMap
 }
 
+var driverCapabilities = map[string]func(driver.Driver) bool{
+	"get_root": func(d driver.Driver) bool { _, ok := d.(driver.GetRooter); return ok },
+	"get":      func(d driver.Driver) bool { _, ok := d.(driver.Getter); return ok },
+	"mkdir": func(d driver.Driver) bool {
+		_, ok1 := d.(driver.Mkdir)
+		_, ok2 := d.(driver.MkdirResult)
+		return ok1 || ok2
+	},
+	"move": func(d driver.Driver) bool {
+		_, ok1 := d.(driver.Move)
+		_, ok2 := d.(driver.MoveResult)
+		return ok1 || ok2
+	},
+	"rename": func(d driver.Driver) bool {
+		_, ok1 := d.(driver.Rename)
+		_, ok2 := d.(driver.RenameResult)
+		return ok1 || ok2
+	},
+	"copy": func(d driver.Driver) bool {
+		_, ok1 := d.(driver.Copy)
+		_, ok2 := d.(driver.CopyResult)
+		return ok1 || ok2
+	},
+	"remove": func(d driver.Driver) bool { _, ok := d.(driver.Remove); return ok },
+	"put": func(d driver.Driver) bool {
+		_, ok1 := d.(driver.Put)
+		_, ok2 := d.(driver.PutResult)
+		return ok1 || ok2
+	},
+	"put_url": func(d driver.Driver) bool {
+		_, ok1 := d.(driver.PutURL)
+		_, ok2 := d.(driver.PutURLResult)
+		return ok1 || ok2
+	},
+	"archive": func(d driver.Driver) bool { _, ok := d.(driver.ArchiveReader); return ok },
+	"archive_decompress": func(d driver.Driver) bool {
+		_, ok1 := d.(driver.ArchiveDecompress)
+		_, ok2 := d.(driver.ArchiveDecompressResult)
+		return ok1 || ok2
+	},
+	"other": func(d driver.Driver) bool { _, ok := d.(driver.Other); return ok },
+}
+
+// DriverHasCapability reports whether the driver implements the optional interface
+// named by capability, such as "put", "mkdir" or "archive"
+func DriverHasCapability(name string, capability string) bool {
+	driverNew, ok := driverMap[name]
+	if !ok {
+		return false
+	}
+	check, ok := driverCapabilities[capability]
+	if !ok {
+		return false
+	}
+	return check(driverNew())
+}
+
+type DriverConfigIssue struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ValidateDriverAddition checks the addition of a driver without initializing it,
+// an empty result means the addition can be used to create a storage
+func ValidateDriverAddition(driverName string, addition string) ([]DriverConfigIssue, error) {
+	driverNew, err := GetDriver(driverName)
+	if err != nil {
+		return nil, err
+	}
+	if addition == "" {
+		addition = "{}"
+	}
+	var issues []DriverConfigIssue
+	values := make(map[string]interface{})
+	if err := utils.Json.UnmarshalFromString(addition, &values); err != nil {
+		return append(issues, DriverConfigIssue{Message: fmt.Sprintf("invalid addition: %v", err)}), nil
+	}
+	if err := utils.Json.UnmarshalFromString(addition, driverNew().GetAddition()); err != nil {
+		issues = append(issues, DriverConfigIssue{Message: fmt.Sprintf("failed to unmarshal addition: %v", err)})
+	}
+	for _, item := range driverInfoMap[driverName].Additional {
+		value, ok := values[item.Name]
+		str, isStr := value.(string)
+		if item.Required && (!ok || value == nil || (isStr && str == "")) {
+			issues = append(issues, DriverConfigIssue{Field: item.Name, Message: "required"})
+			continue
+		}
+		if item.Type == conf.TypeSelect && item.Options != "" && isStr && str != "" &&
+			!utils.SliceContains(strings.Split(item.Options, ","), str) {
+			issues = append(issues, DriverConfigIssue{
+				Field:   item.Name,
+				Message: fmt.Sprintf("must be one of [%s]", item.Options),
+			})
+		}
+	}
+	return issues, nil
+}
+
 func registerDriverItems(config driver.Config, addition driver.Additional) {
 	// log.Debugf("addition of %s: %+v", config.Name, addition)
 	tAddition := reflect.TypeOf(addition)
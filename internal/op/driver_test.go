@@ -15,3 +15,40 @@ func TestDriverItemsMap(t *testing.T) {
 		t.Errorf("expected driverInfoMap not empty, but got empty")
 	}
 }
+
+func TestValidateDriverAddition(t *testing.T) {
+	var cases = []struct {
+		addition string
+		valid    bool
+	}{
+		{addition: `{"root_folder_path":".","thumbnail":false}`, valid: true},
+		{addition: `{"root_folder_path":"","thumbnail":false}`, valid: false},
+		{addition: `{"root_folder_path":"."}`, valid: false},
+		{addition: `{"root_folder_path":1,"thumbnail":false}`, valid: false},
+		{addition: `not json`, valid: false},
+	}
+	for _, c := range cases {
+		issues, err := op.ValidateDriverAddition("Local", c.addition)
+		if err != nil {
+			t.Fatalf("failed to validate addition %s: %+v", c.addition, err)
+		}
+		if (len(issues) == 0) != c.valid {
+			t.Errorf("addition %s: expected valid=%v, got issues %+v", c.addition, c.valid, issues)
+		}
+	}
+	if _, err := op.ValidateDriverAddition("None", "{}"); err == nil {
+		t.Errorf("expected error for unknown driver")
+	}
+}
+
+func TestDriverHasCapability(t *testing.T) {
+	if !op.DriverHasCapability("Local", "put") {
+		t.Errorf("expected Local driver to support put")
+	}
+	if op.DriverHasCapability("Local", "unknown") {
+		t.Errorf("expected unknown capability to be unsupported")
+	}
+	if op.DriverHasCapability("None", "put") {
+		t.Errorf("expected unknown driver to be unsupported")
+	}
+}
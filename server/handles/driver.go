@@ -2,14 +2,62 @@ package handles
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/OpenListTeam/OpenList/v4/internal/db"
+	"github.com/OpenListTeam/OpenList/v4/internal/driver"
+	"github.com/OpenListTeam/OpenList/v4/internal/model"
 	"github.com/OpenListTeam/OpenList/v4/internal/op"
 	"github.com/OpenListTeam/OpenList/v4/server/common"
 	"github.com/gin-gonic/gin"
 )
 
+type ListDriverInfoReq struct {
+	model.PageReq
+	Name       string `json:"name" form:"name"`
+	Capability string `json:"capability" form:"capability"`
+}
+
 func ListDriverInfo(c *gin.Context) {
-	common.SuccessResp(c, op.GetDriverInfoMap())
+	var req ListDriverInfoReq
+	if err := c.ShouldBind(&req); err != nil {
+		common.ErrorResp(c, err, 400)
+		return
+	}
+	infoMap := op.GetDriverInfoMap()
+	// keep the full map response for clients that don't filter or paginate
+	if req == (ListDriverInfoReq{}) {
+		common.SuccessResp(c, infoMap)
+		return
+	}
+	req.Validate()
+	names := op.GetDriverNames()
+	sort.Strings(names)
+	name := strings.ToLower(req.Name)
+	infos := make([]driver.Info, 0, len(names))
+	for _, n := range names {
+		if name != "" && !strings.Contains(strings.ToLower(n), name) {
+			continue
+		}
+		if req.Capability != "" && !op.DriverHasCapability(n, req.Capability) {
+			continue
+		}
+		infos = append(infos, infoMap[n])
+	}
+	total := len(infos)
+	start := total
+	if req.Page-1 <= total/req.PerPage {
+		start = (req.Page - 1) * req.PerPage
+	}
+	end := total
+	if total-start > req.PerPage {
+		end = start + req.PerPage
+	}
+	common.SuccessResp(c, common.PageResp{
+		Content: infos[start:end],
+		Total:   int64(total),
+	})
 }
 
 func ListDriverNames(c *gin.Context) {
@@ -26,3 +74,66 @@ func GetDriverInfo(c *gin.Context) {
 	}
 	common.SuccessResp(c, items)
 }
+
+type ValidateDriverReq struct {
+	Driver   string `json:"driver" binding:"required"`
+	Addition string `json:"addition"`
+}
+
+func ValidateDriver(c *gin.Context) {
+	var req ValidateDriverReq
+	if err := c.ShouldBind(&req); err != nil {
+		common.ErrorResp(c, err, 400)
+		return
+	}
+	issues, err := op.ValidateDriverAddition(req.Driver, req.Addition)
+	if err != nil {
+		common.ErrorResp(c, err, 400)
+		return
+	}
+	common.SuccessResp(c, gin.H{
+		"valid":  len(issues) == 0,
+		"issues": issues,
+	})
+}
+
+type DriverUsageResp struct {
+	Driver     string   `json:"driver"`
+	Storages   int      `json:"storages"`
+	Enabled    int      `json:"enabled"`
+	MountPaths []string `json:"mount_paths"`
+}
+
+// ListDriverUsage reports how many storages use each registered driver,
+// drivers without any storage are included with zero counts
+func ListDriverUsage(c *gin.Context) {
+	storages, _, err := db.GetStorages(1, model.MaxInt)
+	if err != nil {
+		common.ErrorResp(c, err, 500)
+		return
+	}
+	names := op.GetDriverNames()
+	sort.Strings(names)
+	usages := make(map[string]*DriverUsageResp, len(names))
+	resp := make([]*DriverUsageResp, 0, len(names))
+	for _, name := range names {
+		usage := &DriverUsageResp{Driver: name, MountPaths: []string{}}
+		usages[name] = usage
+		resp = append(resp, usage)
+	}
+	for _, storage := range storages {
+		usage, ok := usages[storage.Driver]
+		if !ok {
+			// storage of a driver that isn't compiled in
+			usage = &DriverUsageResp{Driver: storage.Driver, MountPaths: []string{}}
+			usages[storage.Driver] = usage
+			resp = append(resp, usage)
+		}
+		usage.Storages++
+		if !storage.Disabled {
+			usage.Enabled++
+		}
+		usage.MountPaths = append(usage.MountPaths, storage.MountPath)
+	}
+	common.SuccessResp(c, resp)
+}
@@ -134,6 +134,8 @@ func admin(g *gin.RouterGroup) {
 	driver.GET("/list", handles.ListDriverInfo)
 	driver.GET("/names", handles.ListDriverNames)
 	driver.GET("/info", handles.GetDriverInfo)
+	driver.POST("/validate", handles.ValidateDriver)
+	driver.GET("/usage", handles.ListDriverUsage)
 
 	setting := g.Group("/setting")
 	setting.GET("/get", handles.GetSetting)